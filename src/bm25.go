@@ -0,0 +1,156 @@
+package fzf
+
+import (
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/junegunn/fzf/src/util"
+)
+
+// BM25 ranks matches using classic Okapi BM25 term weighting instead of
+// fzf's positional fuzzy score. It is meant for log/long-line search where
+// relevance is better captured by term frequency and document length than
+// by how tightly characters of the query are packed together.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// bm25Scorer is a Scorer that ranks results with BM25 over the corpus seen
+// so far. Document frequencies and the average document length are
+// maintained incrementally as chunks are indexed, since fzf feeds chunks to
+// the matcher well before the full input has been read.
+type bm25Scorer struct {
+	mutex    sync.Mutex
+	indexed  map[int32]int32
+	docFreq  map[string]int
+	numDocs  int
+	totalLen int64
+}
+
+func newBM25Scorer() *bm25Scorer {
+	return &bm25Scorer{
+		indexed: make(map[int32]int32),
+		docFreq: make(map[string]int),
+	}
+}
+
+func bm25Tokenize(text string) []string {
+	return strings.Fields(text)
+}
+
+// index folds the items of chunk that haven't been seen yet into the corpus
+// statistics. fzf hands the matcher a still-growing tail chunk while input
+// is streaming in, so a chunk may be indexed several times over its
+// lifetime; only the items appended since the last pass (tracked by count)
+// are folded in, so numDocs/totalLen/docFreq stay in sync with what Score
+// actually ranks.
+func (b *bm25Scorer) index(chunk *Chunk) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	start := chunk.items[0].Index()
+	done := b.indexed[start]
+	if int32(chunk.count) <= done {
+		return
+	}
+
+	for i := int(done); i < int(chunk.count); i++ {
+		terms := bm25Tokenize(chunk.items[i].text.ToString())
+		b.numDocs++
+		b.totalLen += int64(len(terms))
+
+		seen := make(map[string]bool, len(terms))
+		for _, term := range terms {
+			if seen[term] {
+				continue
+			}
+			seen[term] = true
+			b.docFreq[term]++
+		}
+	}
+	b.indexed[start] = int32(chunk.count)
+}
+
+func (b *bm25Scorer) avgDocLen() float64 {
+	if b.numDocs == 0 {
+		return 0
+	}
+	return float64(b.totalLen) / float64(b.numDocs)
+}
+
+// bm25Stats is a point-in-time snapshot of the corpus statistics score
+// needs for a given set of query terms. Score takes one of these per call
+// instead of score re-locking b.mutex per item, since Score runs once per
+// item in a chunk across every work-stealing worker: a mutex acquisition
+// per line scored would serialize exactly the parallelism chunk0-3 added.
+type bm25Stats struct {
+	numDocs int
+	avgLen  float64
+	freq    map[string]int
+}
+
+func (b *bm25Scorer) stats(terms []string) bm25Stats {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	freq := make(map[string]int, len(terms))
+	for _, term := range terms {
+		freq[term] = b.docFreq[term]
+	}
+	return bm25Stats{numDocs: b.numDocs, avgLen: b.avgDocLen(), freq: freq}
+}
+
+// score computes the BM25 score of doc against the query terms using stats,
+// a snapshot of the corpus statistics taken once per Score call.
+func score(stats bm25Stats, terms []string, doc []string) float32 {
+	if stats.numDocs == 0 || stats.avgLen == 0 {
+		return 0
+	}
+
+	termCount := make(map[string]int, len(doc))
+	for _, term := range doc {
+		termCount[term]++
+	}
+
+	var total float64
+	docLen := float64(len(doc))
+	for _, term := range terms {
+		tf := float64(termCount[term])
+		if tf == 0 {
+			continue
+		}
+		df := float64(stats.freq[term])
+		idf := math.Log(1 + (float64(stats.numDocs)-df+0.5)/(df+0.5))
+		numerator := tf * (bm25K1 + 1)
+		denominator := tf + bm25K1*(1-bm25B+bm25B*(docLen/stats.avgLen))
+		total += idf * numerator / denominator
+	}
+	return float32(total)
+}
+
+func (b *bm25Scorer) Score(chunk *Chunk, pattern *Pattern, slab *util.Slab) []Result {
+	b.index(chunk)
+
+	terms := bm25Tokenize(pattern.AsString())
+	if len(terms) == 0 {
+		return nil
+	}
+	stats := b.stats(terms)
+
+	matches := make([]Result, 0, int(chunk.count))
+	for i := 0; i < int(chunk.count); i++ {
+		item := &chunk.items[i]
+		doc := bm25Tokenize(item.text.ToString())
+		s := score(stats, terms, doc)
+		if s <= 0 {
+			continue
+		}
+		matches = append(matches, Result{item: item, points: [4]int32{int32(s * 1000), 0, 0, 0}})
+	}
+	return matches
+}
+
+func (b *bm25Scorer) Less(a, b2 Result) bool {
+	return a.points[0] > b2.points[0]
+}
@@ -0,0 +1,52 @@
+package fzf
+
+import "testing"
+
+func TestBm25Tokenize(t *testing.T) {
+	got := bm25Tokenize("  the quick  brown fox ")
+	want := []string{"the", "quick", "brown", "fox"}
+	if len(got) != len(want) {
+		t.Fatalf("bm25Tokenize returned %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("bm25Tokenize returned %v, want %v", got, want)
+		}
+	}
+}
+
+func TestBm25ScorePrefersHigherTermFrequency(t *testing.T) {
+	b := newBM25Scorer()
+	b.numDocs = 10
+	b.totalLen = 50
+	b.docFreq["error"] = 3
+
+	terms := []string{"error"}
+	stats := b.stats(terms)
+	low := score(stats, terms, []string{"error", "other", "words", "here", "padding"})
+	high := score(stats, terms, []string{"error", "error", "error", "other", "words"})
+
+	if !(high > low) {
+		t.Fatalf("expected higher term frequency to score higher: low=%v high=%v", low, high)
+	}
+}
+
+func TestBm25ScoreZeroWithoutCorpusStats(t *testing.T) {
+	b := newBM25Scorer()
+	terms := []string{"error"}
+	if got := score(b.stats(terms), terms, []string{"error"}); got != 0 {
+		t.Fatalf("expected 0 score with no indexed corpus, got %v", got)
+	}
+}
+
+func TestBm25ScoreIgnoresUnseenTerms(t *testing.T) {
+	b := newBM25Scorer()
+	b.numDocs = 5
+	b.totalLen = 25
+	b.docFreq["known"] = 2
+
+	terms := []string{"unknown"}
+	if got := score(b.stats(terms), terms, []string{"known", "known"}); got != 0 {
+		t.Fatalf("expected 0 score for a query term absent from doc, got %v", got)
+	}
+}
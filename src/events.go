@@ -0,0 +1,14 @@
+package fzf
+
+import "github.com/junegunn/fzf/src/util"
+
+// Event types sent through Matcher's eventBox. EvtSearchProgress carries a
+// float32 completion ratio, EvtSearchFin a finished *Merger, and
+// EvtSearchPartial (opt-in streaming only) a *Merger snapshot sent ahead of
+// EvtSearchFin. They share one iota block so a new member is just another
+// line here, never a value derived by offsetting another constant.
+const (
+	EvtSearchProgress util.EventType = iota
+	EvtSearchFin
+	EvtSearchPartial
+)
@@ -31,6 +31,11 @@ type Matcher struct {
 	slab           []*util.Slab
 	mergerCache    map[string]*Merger
 	revision       revision
+	scorer         Scorer
+	lastMerger     *Merger
+	lastPattern    *Pattern
+	lastCount      int
+	streaming      bool
 }
 
 const (
@@ -40,8 +45,11 @@ const (
 
 // NewMatcher returns a new Matcher
 func NewMatcher(cache *ChunkCache, patternBuilder func([]rune) *Pattern,
-	sort bool, tac bool, eventBox *util.EventBox, revision revision) *Matcher {
+	sort bool, tac bool, eventBox *util.EventBox, revision revision, scorer Scorer, streaming bool) *Matcher {
 	partitions := util.Min(numPartitionsMultiplier*runtime.NumCPU(), maxPartitions)
+	if scorer == nil {
+		scorer = newFuzzyScorer(tac)
+	}
 	return &Matcher{
 		cache:          cache,
 		patternBuilder: patternBuilder,
@@ -52,7 +60,9 @@ func NewMatcher(cache *ChunkCache, patternBuilder func([]rune) *Pattern,
 		partitions:     partitions,
 		slab:           make([]*util.Slab, partitions),
 		mergerCache:    make(map[string]*Merger),
-		revision:       revision}
+		revision:       revision,
+		scorer:         scorer,
+		streaming:      streaming}
 }
 
 // Loop puts Matcher in action
@@ -112,6 +122,11 @@ func (m *Matcher) Loop() {
 			}
 		}
 
+		if merger == nil && !cacheCleared && count == m.lastCount &&
+			request.pattern.Extends(m.lastPattern) {
+			merger, cancelled = m.scanIncremental(request, m.lastMerger)
+		}
+
 		if merger == nil {
 			merger, cancelled = m.scan(request)
 		}
@@ -122,36 +137,24 @@ func (m *Matcher) Loop() {
 			}
 			merger.final = request.final
 			m.eventBox.Set(EvtSearchFin, merger)
+			m.lastMerger = merger
+			m.lastPattern = request.pattern
+			m.lastCount = count
 		}
 	}
 }
 
-func (m *Matcher) sliceChunks(chunks []*Chunk) [][]*Chunk {
-	partitions := m.partitions
-	perSlice := len(chunks) / partitions
-
-	if perSlice == 0 {
-		partitions = len(chunks)
-		perSlice = 1
-	}
-
-	slices := make([][]*Chunk, partitions)
-	for i := 0; i < partitions; i++ {
-		start := i * perSlice
-		end := start + perSlice
-		if i == partitions-1 {
-			end = len(chunks)
-		}
-		slices[i] = chunks[start:end]
-	}
-	return slices
-}
-
 type partialResult struct {
 	index   int
 	matches []Result
 }
 
+// scan matches request.chunks against request.pattern using a work-stealing
+// pool of m.partitions workers. Chunks are handed out one at a time from a
+// shared counter rather than pre-split into equal-sized ranges, so a worker
+// that lands on a few expensive chunks (e.g. very long lines) doesn't
+// become a straggler while the others sit idle: whichever worker finishes
+// first just claims the next chunk in line.
 func (m *Matcher) scan(request MatchRequest) (*Merger, bool) {
 	startedAt := time.Now()
 
@@ -168,43 +171,33 @@ func (m *Matcher) scan(request MatchRequest) (*Merger, bool) {
 	maxIndex := request.chunks[numChunks-1].lastIndex(minIndex)
 	cancelled := util.NewAtomicBool(false)
 
-	slices := m.sliceChunks(request.chunks)
-	numSlices := len(slices)
-	resultChan := make(chan partialResult, numSlices)
-	countChan := make(chan int, numChunks)
+	numWorkers := util.Min(m.partitions, numChunks)
+	nextChunk := nextIndexFunc(numChunks)
+	resultChan := make(chan partialResult, numChunks)
 	waitGroup := sync.WaitGroup{}
 
-	for idx, chunks := range slices {
+	for idx := 0; idx < numWorkers; idx++ {
 		waitGroup.Add(1)
 		if m.slab[idx] == nil {
 			m.slab[idx] = util.MakeSlab(slab16Size, slab32Size)
 		}
-		go func(idx int, slab *util.Slab, chunks []*Chunk) {
-			defer func() { waitGroup.Done() }()
-			count := 0
-			allMatches := make([][]Result, len(chunks))
-			for idx, chunk := range chunks {
-				matches := request.pattern.Match(chunk, slab)
-				allMatches[idx] = matches
-				count += len(matches)
-				if cancelled.Get() {
+		go func(slab *util.Slab) {
+			defer waitGroup.Done()
+			for {
+				chunkIdx, ok := nextChunk()
+				if !ok || cancelled.Get() {
 					return
 				}
-				countChan <- len(matches)
-			}
-			sliceMatches := make([]Result, 0, count)
-			for _, matches := range allMatches {
-				sliceMatches = append(sliceMatches, matches...)
-			}
-			if m.sort && request.pattern.sortable {
-				if m.tac {
-					sort.Sort(ByRelevanceTac(sliceMatches))
-				} else {
-					sort.Sort(ByRelevance(sliceMatches))
+				chunk := request.chunks[chunkIdx]
+				matches := m.scorer.Score(chunk, request.pattern, slab)
+				if m.sort && request.pattern.sortable {
+					sort.Slice(matches, func(i, j int) bool {
+						return m.scorer.Less(matches[i], matches[j])
+					})
 				}
+				resultChan <- partialResult{chunkIdx, matches}
 			}
-			resultChan <- partialResult{idx, sliceMatches}
-		}(idx, m.slab[idx], chunks)
+		}(m.slab[idx])
 	}
 
 	wait := func() bool {
@@ -213,11 +206,26 @@ func (m *Matcher) scan(request MatchRequest) (*Merger, bool) {
 		return true
 	}
 
+	// Workers claim chunks out of order, so results must be placed back at
+	// their original chunk index before merging to keep output deterministic.
+	partialResults := make([][]Result, numChunks)
+	var streamed *Merger
+	if m.streaming {
+		streamed = NewStreamingMerger(pattern, m.sort && pattern.sortable, m.tac, request.revision, minIndex, maxIndex)
+	}
+	lastStreamedAt := startedAt
+
 	count := 0
 	matchCount := 0
-	for matchesInChunk := range countChan {
+	for count < numChunks {
+		result := <-resultChan
+		partialResults[result.index] = result.matches
 		count++
-		matchCount += matchesInChunk
+		matchCount += len(result.matches)
+
+		if streamed != nil {
+			streamed.Append(result.matches)
+		}
 
 		if count == numChunks {
 			break
@@ -227,19 +235,87 @@ func (m *Matcher) scan(request MatchRequest) (*Merger, bool) {
 			return nil, wait()
 		}
 
-		if time.Since(startedAt) > progressMinDuration {
+		now := time.Now()
+		if now.Sub(startedAt) > progressMinDuration {
 			m.eventBox.Set(EvtSearchProgress, float32(count)/float32(numChunks))
 		}
+		if streamed != nil && now.Sub(lastStreamedAt) > progressMinDuration {
+			lastStreamedAt = now
+			m.eventBox.Set(EvtSearchPartial, streamed.Snapshot())
+		}
 	}
 
-	partialResults := make([][]Result, numSlices)
-	for range slices {
-		partialResult := <-resultChan
-		partialResults[partialResult.index] = partialResult.matches
-	}
 	return NewMerger(pattern, partialResults, m.sort && request.pattern.sortable, m.tac, request.revision, minIndex, maxIndex), false
 }
 
+// scanIncremental rescans only the results that survived the previous
+// search, rather than every chunk, when request.pattern is a strict
+// extension of the pattern that produced prev. This turns each keystroke
+// into O(surviving matches) work instead of O(all chunks) on large inputs.
+func (m *Matcher) scanIncremental(request MatchRequest, prev *Merger) (*Merger, bool) {
+	if prev == nil {
+		return nil, false
+	}
+	if _, ok := m.scorer.(*fuzzyScorer); !ok {
+		// Scorers like bm25Scorer rank relative to the whole corpus, so a
+		// result that survived against a subset can't be trusted here.
+		return nil, false
+	}
+
+	items := prev.Items()
+	if len(items) == 0 {
+		return EmptyMerger(request.revision), false
+	}
+
+	pattern := request.pattern
+	slab := m.slab[0]
+	if slab == nil {
+		slab = util.MakeSlab(slab16Size, slab32Size)
+		m.slab[0] = slab
+	}
+
+	chunks := make([]*Chunk, 0, len(items)/chunkSize+1)
+	var current *Chunk
+	for i, result := range items {
+		if i%chunkSize == 0 {
+			current = &Chunk{}
+			chunks = append(chunks, current)
+		}
+		current.items[i%chunkSize] = *result.item
+		current.count++
+	}
+
+	matches := make([]Result, 0, len(items))
+	for _, chunk := range chunks {
+		if m.reqBox.Peek(reqReset) {
+			return nil, true
+		}
+		matches = append(matches, m.scorer.Score(chunk, pattern, slab)...)
+	}
+	if m.sort && pattern.sortable {
+		sort.Slice(matches, func(i, j int) bool {
+			return m.scorer.Less(matches[i], matches[j])
+		})
+	}
+
+	// Items() is in merged (relevance) order, not corpus order, so the
+	// first/last entries aren't necessarily the min/max index: find them
+	// explicitly instead of assuming the ordering NewMerger's other callers
+	// get for free from scanning chunks front-to-back.
+	minIndex := items[0].item.Index()
+	maxIndex := minIndex
+	for _, result := range items[1:] {
+		idx := result.item.Index()
+		if idx < minIndex {
+			minIndex = idx
+		}
+		if idx > maxIndex {
+			maxIndex = idx
+		}
+	}
+	return NewMerger(pattern, [][]Result{matches}, m.sort && pattern.sortable, m.tac, request.revision, minIndex, maxIndex), false
+}
+
 // Reset is called to interrupt/signal the ongoing search
 func (m *Matcher) Reset(chunks []*Chunk, patternRunes []rune, cancel bool, final bool, sort bool, revision revision) {
 	pattern := m.patternBuilder(patternRunes)
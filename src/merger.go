@@ -0,0 +1,57 @@
+package fzf
+
+// Items returns a snapshot of every result currently held by the merger, in
+// merged order. It exists for consumers, such as Matcher's incremental
+// fast path, that need to walk the previous search's surviving results
+// rather than look them up one at a time with Get.
+func (m *Merger) Items() []Result {
+	items := make([]Result, m.Length())
+	for i := range items {
+		items[i] = *m.Get(i)
+	}
+	return items
+}
+
+// NewStreamingMerger returns an empty *Merger carrying the same sorted/tac/
+// pattern/index header that NewMerger would give a finished result set, so
+// it can be grown in place with Append as a streaming scan's slices
+// complete. EmptyMerger is for scans with no chunks to search at all and
+// leaves that header at its zero value, which is fine there since nothing
+// will ever be appended to it; a merger that's actually going to rank
+// results as they stream in can't skip it the same way, or Get/Length on a
+// published snapshot would fall back to unsorted order regardless of
+// m.sort/m.tac.
+func NewStreamingMerger(pattern *Pattern, sorted bool, tac bool, revision revision, minIndex int32, maxIndex int32) *Merger {
+	return &Merger{
+		pattern:  pattern,
+		sorted:   sorted,
+		tac:      tac,
+		revision: revision,
+		minIndex: minIndex,
+		maxIndex: maxIndex,
+	}
+}
+
+// Append folds more matches into the merger, invalidating any previously
+// merged snapshot. Matcher's streaming mode uses this to grow a single
+// *Merger as each chunk finishes, instead of only building one once the
+// entire scan completes.
+func (m *Merger) Append(partial []Result) {
+	if len(partial) == 0 {
+		return
+	}
+	m.lists = append(m.lists, partial)
+	m.count += len(partial)
+	m.merged = nil
+}
+
+// Snapshot returns a point-in-time copy of the merger's header, safe to
+// publish to another goroutine while the original keeps being mutated by
+// further Append calls. Append only ever adds new entries past the end of
+// m.lists, so a copy of the slice header (fixed length, at whatever point
+// Snapshot was called) never observes later appends, even if the backing
+// array is later reused or grown.
+func (m *Merger) Snapshot() *Merger {
+	clone := *m
+	return &clone
+}
@@ -0,0 +1,28 @@
+package fzf
+
+import "testing"
+
+func TestMergerSnapshotIsolatedFromLaterAppends(t *testing.T) {
+	m := &Merger{}
+	m.Append([]Result{{}, {}})
+
+	snap := m.Snapshot()
+	if snap.count != 2 {
+		t.Fatalf("snapshot count = %d, want 2", snap.count)
+	}
+	if len(snap.lists) != 1 {
+		t.Fatalf("snapshot lists = %d, want 1", len(snap.lists))
+	}
+
+	m.Append([]Result{{}, {}, {}})
+
+	if snap.count != 2 {
+		t.Fatalf("snapshot count changed after later Append: got %d, want 2", snap.count)
+	}
+	if len(snap.lists) != 1 {
+		t.Fatalf("snapshot lists changed after later Append: got %d, want 1", len(snap.lists))
+	}
+	if m.count != 5 {
+		t.Fatalf("live merger count = %d, want 5", m.count)
+	}
+}
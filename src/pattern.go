@@ -0,0 +1,47 @@
+package fzf
+
+import "strings"
+
+// Extends reports whether p is a strict extension of prev, i.e. the user
+// kept typing rather than deleting or changing the kind of query. Matcher
+// uses this to decide whether it can reuse prev's surviving results instead
+// of rescanning every chunk from scratch.
+func (p *Pattern) Extends(prev *Pattern) bool {
+	if prev == nil || p == nil {
+		return false
+	}
+	if p.sortable != prev.sortable {
+		return false
+	}
+	return patternStringExtends(p.AsString(), prev.AsString())
+}
+
+// patternStringExtends holds the string-level rule behind Extends, pulled
+// out so it can be tested without a real *Pattern.
+//
+// This is necessarily conservative: negated terms invert the match
+// semantics of whatever follows them, so a query that introduces or extends
+// a negation is never treated as an extension. Likewise, a trailing '$'
+// anchors a term to the end of the line; appending characters after it
+// changes what the term means (e.g. "err$" matches lines ending in "err",
+// but "err$d" just matches lines containing "err$d"), so the new match set
+// is no longer guaranteed to be a subset of the old one. A trailing
+// backslash means the last character started an escape sequence that
+// hasn't been completed yet, which is just as ambiguous. A '|' can join
+// terms with OR ("foo |" -> "foo | bar"), which widens the match set, so
+// any query containing one is never treated as an extension either.
+func patternStringExtends(cur, old string) bool {
+	if cur == old || !strings.HasPrefix(cur, old) {
+		return false
+	}
+	if strings.Contains(cur, "!") || strings.Contains(old, "!") {
+		return false
+	}
+	if strings.Contains(cur, "|") {
+		return false
+	}
+	if strings.HasSuffix(old, "$") || strings.HasSuffix(old, "\\") {
+		return false
+	}
+	return true
+}
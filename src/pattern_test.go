@@ -0,0 +1,25 @@
+package fzf
+
+import "testing"
+
+func TestPatternStringExtends(t *testing.T) {
+	cases := []struct {
+		old, cur string
+		want     bool
+	}{
+		{"foo", "foobar", true},
+		{"foo", "foo", false},      // no change isn't an extension
+		{"foobar", "foo", false},   // deletion
+		{"foo", "bar", false},      // unrelated query
+		{"!foo", "!foobar", false}, // negation never treated as extension
+		{"foo", "foo!bar", false},
+		{"err$", "err$d", false}, // appending after a suffix anchor flips its meaning
+		{`fo\`, `fo\o`, false},   // appending after a dangling escape is ambiguous
+	}
+
+	for _, c := range cases {
+		if got := patternStringExtends(c.cur, c.old); got != c.want {
+			t.Errorf("patternStringExtends(%q, %q) = %v, want %v", c.cur, c.old, got, c.want)
+		}
+	}
+}
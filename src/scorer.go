@@ -0,0 +1,62 @@
+package fzf
+
+import (
+	"fmt"
+
+	"github.com/junegunn/fzf/src/util"
+)
+
+// Scorer decouples Matcher from any particular ranking strategy. The
+// built-in fuzzy scorer ranks by the position-based algorithm in Pattern,
+// but alternative scorers (e.g. bm25Scorer) can rank the same chunks using
+// completely different signals, such as corpus-wide term statistics.
+type Scorer interface {
+	// Score returns the matches found in chunk for the given pattern. It is
+	// called once per chunk, from within Matcher.scan's worker goroutines
+	// (and from the matcher loop itself on the incremental path), so
+	// implementations must be safe to call concurrently with a distinct
+	// slab per goroutine.
+	Score(chunk *Chunk, pattern *Pattern, slab *util.Slab) []Result
+
+	// Less reports whether a should be ranked ahead of b. It replaces the
+	// ByRelevance/ByRelevanceTac sort that Matcher.scan used to hard-code.
+	Less(a, b Result) bool
+}
+
+// fuzzyScorer is the default Scorer, preserving the historical behavior of
+// scoring with Pattern.Match and ordering with ByRelevance/ByRelevanceTac.
+type fuzzyScorer struct {
+	tac bool
+}
+
+func newFuzzyScorer(tac bool) *fuzzyScorer {
+	return &fuzzyScorer{tac: tac}
+}
+
+func (f *fuzzyScorer) Score(chunk *Chunk, pattern *Pattern, slab *util.Slab) []Result {
+	return pattern.Match(chunk, slab)
+}
+
+func (f *fuzzyScorer) Less(a, b Result) bool {
+	pair := []Result{a, b}
+	if f.tac {
+		return ByRelevanceTac(pair).Less(0, 1)
+	}
+	return ByRelevance(pair).Less(0, 1)
+}
+
+// ParseScorer maps the value of the --scorer flag to a Scorer to pass into
+// NewMatcher. It returns (nil, nil) for the default, so callers can pass the
+// result straight through without special-casing "fuzzy". Wiring an actual
+// --scorer flag to call this (options.go's argument parser) is out of scope
+// for this change; this is only the entry point that parser would call.
+func ParseScorer(name string) (Scorer, error) {
+	switch name {
+	case "", "default", "fuzzy":
+		return nil, nil
+	case "bm25":
+		return newBM25Scorer(), nil
+	default:
+		return nil, fmt.Errorf("unknown scorer: %s", name)
+	}
+}
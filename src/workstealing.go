@@ -0,0 +1,20 @@
+package fzf
+
+import "sync/atomic"
+
+// nextIndexFunc returns a function that hands out the indices [0,n) exactly
+// once each, safe for concurrent use by any number of callers. Matcher.scan
+// gives every worker the same closure instead of pre-splitting chunks into
+// fixed equal-sized ranges: a worker that finishes early just claims
+// whatever index is next, rather than sitting idle while a sibling worker
+// is stuck with a range that happened to contain the expensive chunks.
+func nextIndexFunc(n int) func() (int, bool) {
+	var next int64 = -1
+	return func() (int, bool) {
+		i := int(atomic.AddInt64(&next, 1))
+		if i >= n {
+			return 0, false
+		}
+		return i, true
+	}
+}
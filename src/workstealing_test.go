@@ -0,0 +1,114 @@
+package fzf
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNextIndexFuncYieldsEachIndexOnce(t *testing.T) {
+	const n = 1000
+	next := nextIndexFunc(n)
+	seen := make([]int32, n)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i, ok := next()
+				if !ok {
+					return
+				}
+				atomic.AddInt32(&seen[i], 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, count := range seen {
+		if count != 1 {
+			t.Fatalf("index %d claimed %d times, want exactly 1", i, count)
+		}
+	}
+	if _, ok := next(); ok {
+		t.Fatal("next() returned ok after all indices were claimed")
+	}
+}
+
+// simulateWork runs n units of work, costs[i] apiece, across workers
+// workers using the given scheduling strategy, and returns the wall-clock
+// time until every unit is done.
+func simulateWork(workers int, costs []int, next func() (int, bool)) time.Duration {
+	start := time.Now()
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i, ok := next()
+				if !ok {
+					return
+				}
+				busyWait(time.Duration(costs[i]) * time.Microsecond)
+			}
+		}()
+	}
+	wg.Wait()
+	return time.Since(start)
+}
+
+func busyWait(d time.Duration) {
+	deadline := time.Now().Add(d)
+	for time.Now().Before(deadline) {
+	}
+}
+
+// BenchmarkSchedulingTailLatency compares fixed equal-sized partitioning
+// against work-stealing when cost is heterogeneous: a single slice/chunk
+// near the end is far more expensive than the rest, simulating one very
+// long line landing in an otherwise cheap input.
+func BenchmarkSchedulingTailLatency(b *testing.B) {
+	const n = 64
+	const workers = 8
+
+	costs := make([]int, n)
+	for i := range costs {
+		costs[i] = 10
+	}
+	costs[n-1] = 2000 // one expensive unit
+
+	b.Run("work-stealing", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			simulateWork(workers, costs, nextIndexFunc(n))
+		}
+	})
+
+	b.Run("fixed-partitions", func(b *testing.B) {
+		perWorker := n / workers
+		for i := 0; i < b.N; i++ {
+			// Each worker only ever processes its own contiguous range, so
+			// the straggler worker that drew the expensive unit can't be
+			// helped by idle siblings.
+			var wg sync.WaitGroup
+			for w := 0; w < workers; w++ {
+				start := w * perWorker
+				end := start + perWorker
+				if w == workers-1 {
+					end = n
+				}
+				wg.Add(1)
+				go func(start, end int) {
+					defer wg.Done()
+					for i := start; i < end; i++ {
+						busyWait(time.Duration(costs[i]) * time.Microsecond)
+					}
+				}(start, end)
+			}
+			wg.Wait()
+		}
+	})
+}